@@ -0,0 +1,34 @@
+// Command escapereport prints the compiler's escape-analysis decisions for
+// the tutorial examples as a table.
+//
+// Usage:
+//
+//	go run ./cmd/escapereport [dir]
+//
+// dir defaults to ./examples.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/shreya-sk/go-memory-pattern/escapetest"
+)
+
+func main() {
+	dir := "./examples"
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+
+	facts, err := escapetest.Analyze(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "escapereport: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%-28s %-12s %-18s %s\n", "FUNC", "VAR", "REASON", "POS")
+	for _, f := range facts {
+		fmt.Printf("%-28s %-12s %-18s %s\n", f.Func, f.Var, f.Reason, f.Pos)
+	}
+}
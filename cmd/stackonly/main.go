@@ -0,0 +1,113 @@
+// Command stackonly enforces the tutorial's "stays on stack" claims at
+// build time. It reads a config file listing types that must never escape
+// from a set of functions, scans `go build -gcflags=-m` diagnostics for
+// those functions, and fails with a nonzero exit code if any of them moved
+// to the heap.
+//
+// Usage:
+//
+//	go run ./cmd/stackonly [-config stackonly.json] [-dir ./examples]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/shreya-sk/go-memory-pattern/escapetest"
+)
+
+// Rule declares that Type must not be reported as "moved to heap" from any
+// function whose name matches FuncGlob (a path.Match pattern).
+type Rule struct {
+	Type     string `json:"type"`
+	FuncGlob string `json:"funcGlob"`
+}
+
+// Config is the on-disk shape of a stackonly config file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Violation is one stack-only rule broken by one escape-analysis fact.
+type Violation struct {
+	Rule Rule
+	Fact escapetest.EscapeFact
+}
+
+func main() {
+	configPath := flag.String("config", "stackonly.json", "path to the stackonly rule config")
+	dir := flag.String("dir", "./examples", "directory to analyze")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stackonly: %v\n", err)
+		os.Exit(1)
+	}
+
+	facts, err := escapetest.Analyze(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stackonly: %v\n", err)
+		os.Exit(1)
+	}
+
+	violations, err := check(cfg, facts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stackonly: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(violations) == 0 {
+		fmt.Printf("stackonly: %d rule(s) checked against %s, no violations\n", len(cfg.Rules), *dir)
+		return
+	}
+
+	for _, v := range violations {
+		fmt.Printf("%s: %s moved to heap in %s, violating stack-only rule for %s (glob %q)\n",
+			v.Fact.Pos, v.Fact.Var, v.Fact.Func, v.Rule.Type, v.Rule.FuncGlob)
+	}
+	fmt.Fprintf(os.Stderr, "stackonly: %d violation(s) found\n", len(violations))
+	os.Exit(1)
+}
+
+// loadConfig reads and parses a stackonly config file.
+func loadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading config: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// check matches every "moved to heap" fact against the configured rules and
+// returns one Violation per (rule, fact) pair that breaks an invariant. A
+// fact only matches a rule when both its function name matches FuncGlob and
+// its resolved static type equals Type.
+func check(cfg Config, facts []escapetest.EscapeFact) ([]Violation, error) {
+	var violations []Violation
+	for _, fact := range facts {
+		if fact.Reason != "moved to heap" || fact.Type == "" {
+			continue
+		}
+		for _, rule := range cfg.Rules {
+			if fact.Type != rule.Type {
+				continue
+			}
+			matched, err := path.Match(rule.FuncGlob, fact.Func)
+			if err != nil {
+				return nil, fmt.Errorf("invalid funcGlob %q: %w", rule.FuncGlob, err)
+			}
+			if matched {
+				violations = append(violations, Violation{Rule: rule, Fact: fact})
+			}
+		}
+	}
+	return violations, nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/shreya-sk/go-memory-pattern/escapetest"
+)
+
+func TestCheckFlagsMovedToHeapInMatchingFunc(t *testing.T) {
+	cfg := Config{Rules: []Rule{{Type: "main.Student", FuncGlob: "calculateAverage*"}}}
+	facts := []escapetest.EscapeFact{
+		{Func: "calculateAverageHeap", Var: "student", Reason: "moved to heap", Type: "main.Student", Pos: "stack-vs-heap.go:49:2"},
+		{Func: "calculateAverageStack", Var: "total", Reason: "does not escape", Type: "float64", Pos: "stack-vs-heap.go:18:2"},
+		{Func: "escapesToHeap1", Var: "student", Reason: "moved to heap", Type: "main.Student", Pos: "escape-analysis.go:60:2"},
+	}
+
+	violations, err := check(cfg, facts)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("want 1 violation, got %d: %+v", len(violations), violations)
+	}
+	if violations[0].Fact.Func != "calculateAverageHeap" {
+		t.Errorf("want violation for calculateAverageHeap, got %s", violations[0].Fact.Func)
+	}
+}
+
+func TestCheckIgnoresUnrelatedType(t *testing.T) {
+	cfg := Config{Rules: []Rule{{Type: "main.Student", FuncGlob: "calculateAverage*"}}}
+	facts := []escapetest.EscapeFact{
+		{Func: "calculateAverageHeap", Var: "grades", Reason: "moved to heap", Type: "[]float64", Pos: "stack-vs-heap.go:49:2"},
+	}
+
+	violations, err := check(cfg, facts)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("want no violations for a non-Student escape, got %+v", violations)
+	}
+}
@@ -0,0 +1,50 @@
+// Package collection turns the value-vs-pointer-vs-slice lessons from the
+// examples into a reusable data structure: a generic Collection[T] with
+// three pluggable storage strategies that all share the same API.
+package collection
+
+// Strategy selects how a Collection lays out its elements in memory.
+type Strategy int
+
+const (
+	// ByValue stores elements contiguously in a single []T. Cache-friendly
+	// and allocation-light, but Get and Range hand back copies.
+	ByValue Strategy = iota
+	// ByPointer stores []*T, one heap allocation per element. Lets callers
+	// share and mutate a single underlying element through multiple IDs.
+	ByPointer
+	// ByHandle stores elements in a contiguous []T slab and hands callers
+	// a stable int32 handle into it, so IDs stay valid without exposing a
+	// pointer into the slab (which would force the slab to escape).
+	ByHandle
+)
+
+// Collection is a set of T indexed by an opaque, stable id returned from
+// Insert. All three strategies implement the same API so callers can swap
+// storage without changing call sites.
+type Collection[T any] interface {
+	// Insert adds v to the collection and returns its id.
+	Insert(v T) int
+	// Get returns the element stored at id, or false if id is unknown.
+	Get(id int) (T, bool)
+	// Update calls fn with a pointer to the element stored at id, and
+	// reports whether id was found.
+	Update(id int, fn func(*T)) bool
+	// Range calls fn for every element in insertion order, stopping early
+	// if fn returns false.
+	Range(fn func(id int, v T) bool)
+	// Len returns the number of elements currently stored.
+	Len() int
+}
+
+// New creates an empty Collection[T] using the given storage strategy.
+func New[T any](strategy Strategy) Collection[T] {
+	switch strategy {
+	case ByPointer:
+		return &pointerCollection[T]{}
+	case ByHandle:
+		return &handleCollection[T]{}
+	default:
+		return &valueCollection[T]{}
+	}
+}
@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+const benchSize = 1_000_000
+
+func newBenchCollection(b *testing.B, strategy Strategy) Collection[student] {
+	b.Helper()
+	c := New[student](strategy)
+	for i := 0; i < benchSize; i++ {
+		c.Insert(student{ID: int64(i), Name: "Student", Grade: 75.0})
+	}
+	return c
+}
+
+// BenchmarkRange compares iteration speed across strategies for 1M records.
+func BenchmarkRange(b *testing.B) {
+	for _, strategy := range []struct {
+		name     string
+		strategy Strategy
+	}{
+		{"ByValue", ByValue},
+		{"ByPointer", ByPointer},
+		{"ByHandle", ByHandle},
+	} {
+		b.Run(strategy.name, func(b *testing.B) {
+			c := newBenchCollection(b, strategy.strategy)
+			b.ReportAllocs()
+			b.ResetTimer()
+			var total float64
+			for i := 0; i < b.N; i++ {
+				c.Range(func(id int, s student) bool {
+					total += s.Grade
+					return true
+				})
+			}
+		})
+	}
+}
+
+// BenchmarkGCScanCost compares the GC pause time spent scanning 1M records
+// under each strategy: debug.FreeOSMemory forces a full GC cycle each
+// iteration, and the PauseTotal delta shows the extra scan cost of
+// ByPointer's 1M separate heap objects versus ByValue/ByHandle's one
+// contiguous slab.
+func BenchmarkGCScanCost(b *testing.B) {
+	for _, strategy := range []struct {
+		name     string
+		strategy Strategy
+	}{
+		{"ByValue", ByValue},
+		{"ByPointer", ByPointer},
+		{"ByHandle", ByHandle},
+	} {
+		b.Run(strategy.name, func(b *testing.B) {
+			c := newBenchCollection(b, strategy.strategy)
+
+			var before, after debug.GCStats
+			debug.ReadGCStats(&before)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				debug.FreeOSMemory()
+			}
+			b.StopTimer()
+
+			debug.ReadGCStats(&after)
+			pauseNs := float64(after.PauseTotal-before.PauseTotal) / float64(b.N)
+			b.ReportMetric(pauseNs, "ns/gc-pause")
+			_ = c.Len()
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package collection
+
+import "testing"
+
+type student struct {
+	ID    int64
+	Name  string
+	Grade float64
+}
+
+func TestCollectionStrategies(t *testing.T) {
+	strategies := []Strategy{ByValue, ByPointer, ByHandle}
+
+	for _, strategy := range strategies {
+		c := New[student](strategy)
+
+		id1 := c.Insert(student{ID: 1, Name: "Alice", Grade: 85.0})
+		id2 := c.Insert(student{ID: 2, Name: "Bob", Grade: 92.0})
+
+		if c.Len() != 2 {
+			t.Fatalf("strategy %d: want Len()==2, got %d", strategy, c.Len())
+		}
+
+		got, ok := c.Get(id1)
+		if !ok || got.Name != "Alice" {
+			t.Fatalf("strategy %d: Get(id1) = %+v, %v", strategy, got, ok)
+		}
+
+		if !c.Update(id2, func(s *student) { s.Grade = 100.0 }) {
+			t.Fatalf("strategy %d: Update(id2) reported not found", strategy)
+		}
+		got, _ = c.Get(id2)
+		if got.Grade != 100.0 {
+			t.Errorf("strategy %d: Update did not persist, grade = %v", strategy, got.Grade)
+		}
+
+		if _, ok := c.Get(99); ok {
+			t.Errorf("strategy %d: Get(99) should report not found", strategy)
+		}
+
+		var seen int
+		c.Range(func(id int, s student) bool {
+			seen++
+			return true
+		})
+		if seen != 2 {
+			t.Errorf("strategy %d: Range visited %d elements, want 2", strategy, seen)
+		}
+	}
+}
+
+func TestCollectionRangeStopsEarly(t *testing.T) {
+	c := New[student](ByValue)
+	c.Insert(student{ID: 1})
+	c.Insert(student{ID: 2})
+	c.Insert(student{ID: 3})
+
+	var visited int
+	c.Range(func(id int, s student) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Errorf("want Range to stop after 1 element, visited %d", visited)
+	}
+}
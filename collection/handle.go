@@ -0,0 +1,57 @@
+package collection
+
+// handleCollection stores elements in a contiguous []T slab and hands
+// callers a stable int32 index into it as their id. Unlike pointerCollection
+// it never exposes a pointer into the slab to the caller, so the slab
+// itself stays a plain, non-escaping []T while ids remain valid regardless
+// of how the slab is grown or (in a future compacting implementation)
+// reshuffled.
+type handleCollection[T any] struct {
+	slab    []T
+	handles []int32
+}
+
+func (c *handleCollection[T]) Insert(v T) int {
+	idx := int32(len(c.slab))
+	c.slab = append(c.slab, v)
+	c.handles = append(c.handles, idx)
+	return len(c.handles) - 1
+}
+
+func (c *handleCollection[T]) Get(id int) (T, bool) {
+	idx, ok := c.index(id)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return c.slab[idx], true
+}
+
+func (c *handleCollection[T]) Update(id int, fn func(*T)) bool {
+	idx, ok := c.index(id)
+	if !ok {
+		return false
+	}
+	fn(&c.slab[idx])
+	return true
+}
+
+func (c *handleCollection[T]) Range(fn func(id int, v T) bool) {
+	for id, idx := range c.handles {
+		if !fn(id, c.slab[idx]) {
+			return
+		}
+	}
+}
+
+func (c *handleCollection[T]) Len() int {
+	return len(c.handles)
+}
+
+// index resolves a caller-facing id to its current slab index.
+func (c *handleCollection[T]) index(id int) (int32, bool) {
+	if id < 0 || id >= len(c.handles) {
+		return 0, false
+	}
+	return c.handles[id], true
+}
@@ -0,0 +1,42 @@
+package collection
+
+// pointerCollection stores []*T, one heap allocation per element, the same
+// shape as createStudentByPointer in the examples package. Every element
+// shares a single identity across Get/Update calls, so mutations through
+// one id are visible to every other holder of that id.
+type pointerCollection[T any] struct {
+	items []*T
+}
+
+func (c *pointerCollection[T]) Insert(v T) int {
+	c.items = append(c.items, &v)
+	return len(c.items) - 1
+}
+
+func (c *pointerCollection[T]) Get(id int) (T, bool) {
+	if id < 0 || id >= len(c.items) {
+		var zero T
+		return zero, false
+	}
+	return *c.items[id], true
+}
+
+func (c *pointerCollection[T]) Update(id int, fn func(*T)) bool {
+	if id < 0 || id >= len(c.items) {
+		return false
+	}
+	fn(c.items[id])
+	return true
+}
+
+func (c *pointerCollection[T]) Range(fn func(id int, v T) bool) {
+	for id, p := range c.items {
+		if !fn(id, *p) {
+			return
+		}
+	}
+}
+
+func (c *pointerCollection[T]) Len() int {
+	return len(c.items)
+}
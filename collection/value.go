@@ -0,0 +1,41 @@
+package collection
+
+// valueCollection stores elements contiguously in a single []T, the same
+// layout as the []Student slices in the examples package - cache-friendly
+// iteration, but every Get and Range element is a copy.
+type valueCollection[T any] struct {
+	items []T
+}
+
+func (c *valueCollection[T]) Insert(v T) int {
+	c.items = append(c.items, v)
+	return len(c.items) - 1
+}
+
+func (c *valueCollection[T]) Get(id int) (T, bool) {
+	if id < 0 || id >= len(c.items) {
+		var zero T
+		return zero, false
+	}
+	return c.items[id], true
+}
+
+func (c *valueCollection[T]) Update(id int, fn func(*T)) bool {
+	if id < 0 || id >= len(c.items) {
+		return false
+	}
+	fn(&c.items[id])
+	return true
+}
+
+func (c *valueCollection[T]) Range(fn func(id int, v T) bool) {
+	for id, v := range c.items {
+		if !fn(id, v) {
+			return
+		}
+	}
+}
+
+func (c *valueCollection[T]) Len() int {
+	return len(c.items)
+}
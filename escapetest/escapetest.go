@@ -0,0 +1,220 @@
+// Package escapetest parses `go build -gcflags=-m -m` output into
+// structured facts that tests and tools can assert against.
+package escapetest
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// EscapeFact describes a single escape-analysis decision the compiler made
+// about one variable inside one function.
+type EscapeFact struct {
+	Func   string // enclosing function name, e.g. "calculateAverageStack"
+	Var    string // variable or expression the diagnostic is about
+	Reason string // e.g. "moved to heap", "escapes to heap", "does not escape", "inlined"
+	Pos    string // file:line:col of the diagnostic
+	Type   string // static type of Var, e.g. "main.Student"; empty if it couldn't be resolved
+}
+
+var diagLine = regexp.MustCompile(`^(.+\.go):(\d+):(\d+): (.+)$`)
+
+var (
+	movedToHeap   = regexp.MustCompile(`^moved to heap: (\S+)$`)
+	escapesToHeap = regexp.MustCompile(`^(\S+) escapes to heap$`)
+	doesNotEscape = regexp.MustCompile(`^(\S+) does not escape$`)
+	inliningCall  = regexp.MustCompile(`^inlining call to (\S+)$`)
+)
+
+// funcRange is the half-open line range [Start, End] a function declaration
+// spans in its source file, used to attribute a diagnostic to a function.
+type funcRange struct {
+	name       string
+	start, end int
+}
+
+// Analyze runs `go build -gcflags=-m -m` against the package in dir and
+// returns one EscapeFact per diagnostic line the compiler produced,
+// attributed to the function that line falls inside and, where resolvable,
+// to the variable's static type.
+func Analyze(dir string) ([]EscapeFact, error) {
+	fset, files, err := parsePackage(dir)
+	if err != nil {
+		return nil, fmt.Errorf("escapetest: parsing %s: %w", dir, err)
+	}
+	ranges := funcRanges(fset, files)
+	types := varTypes(fset, files, ranges)
+
+	out, err := compileWithDiagnostics(dir)
+	if err != nil {
+		return nil, fmt.Errorf("escapetest: building %s: %w", dir, err)
+	}
+
+	var facts []EscapeFact
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fact, ok := parseDiagnostic(scanner.Text(), ranges)
+		if ok {
+			fact.Type = types[fact.Func+"|"+strings.TrimPrefix(fact.Var, "&")]
+			facts = append(facts, fact)
+		}
+	}
+	return facts, scanner.Err()
+}
+
+// compileWithDiagnostics builds dir with escape-analysis diagnostics turned
+// up to their most verbose level and returns the combined stderr output.
+// A failing build still carries diagnostics on stderr, so a non-nil exit
+// error is only fatal if no diagnostic lines were produced at all.
+func compileWithDiagnostics(dir string) (string, error) {
+	cmd := exec.Command("go", "build", "-gcflags=-m -m", "-o", os.DevNull, dir)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+	if runErr != nil && !diagLine.MatchString(stderr.String()) {
+		return "", runErr
+	}
+	return stderr.String(), nil
+}
+
+// parseDiagnostic turns one line of `go build -gcflags=-m -m` output into an
+// EscapeFact, reporting ok=false for lines that carry no escape decision
+// (e.g. "./foo.go:1:1: cannot inline bar: ...").
+func parseDiagnostic(line string, ranges []funcRange) (EscapeFact, bool) {
+	m := diagLine.FindStringSubmatch(line)
+	if m == nil {
+		return EscapeFact{}, false
+	}
+	file, lineNo, pos, msg := m[1], m[2], m[3], m[4]
+
+	var (
+		variable string
+		reason   string
+	)
+	switch {
+	case movedToHeap.MatchString(msg):
+		variable = movedToHeap.FindStringSubmatch(msg)[1]
+		reason = "moved to heap"
+	case escapesToHeap.MatchString(msg):
+		variable = escapesToHeap.FindStringSubmatch(msg)[1]
+		reason = "escapes to heap"
+	case doesNotEscape.MatchString(msg):
+		variable = doesNotEscape.FindStringSubmatch(msg)[1]
+		reason = "does not escape"
+	case inliningCall.MatchString(msg):
+		variable = inliningCall.FindStringSubmatch(msg)[1]
+		reason = "inlined"
+	default:
+		return EscapeFact{}, false
+	}
+
+	n, _ := strconv.Atoi(lineNo)
+	return EscapeFact{
+		Func:   funcAt(filepath.Base(file), n, ranges),
+		Var:    variable,
+		Reason: reason,
+		Pos:    fmt.Sprintf("%s:%s:%s", file, lineNo, pos),
+	}, true
+}
+
+// funcAt returns the name of the function whose source range contains line
+// in the given file, or "" if no declaration covers it.
+func funcAt(file string, line int, ranges []funcRange) string {
+	for _, r := range ranges {
+		if !strings.HasSuffix(r.name, "@"+file) {
+			continue
+		}
+		if line >= r.start && line <= r.end {
+			return strings.TrimSuffix(r.name, "@"+file)
+		}
+	}
+	return ""
+}
+
+// parsePackage parses every .go file directly inside dir.
+func parsePackage(dir string) (*token.FileSet, []*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, f)
+	}
+	return fset, files, nil
+}
+
+// funcRanges records the line range of each top-level function declaration
+// across files, keyed by "name@file.go" so funcAt can disambiguate
+// same-named functions across files.
+func funcRanges(fset *token.FileSet, files []*ast.File) []funcRange {
+	var ranges []funcRange
+	for _, f := range files {
+		file := filepath.Base(fset.Position(f.Pos()).Filename)
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			ranges = append(ranges, funcRange{
+				name:  fn.Name.Name + "@" + file,
+				start: fset.Position(fn.Pos()).Line,
+				end:   fset.Position(fn.End()).Line,
+			})
+		}
+	}
+	return ranges
+}
+
+// varTypes type-checks files (best effort - type errors from unresolved
+// imports are ignored) and maps "funcName|varName" to the static type of
+// every local variable and parameter declared in that function, qualified
+// with the declaring package's name (e.g. "main.Student").
+func varTypes(fset *token.FileSet, files []*ast.File, ranges []funcRange) map[string]string {
+	result := make(map[string]string)
+	if len(files) == 0 {
+		return result
+	}
+
+	conf := types.Config{Importer: importer.Default(), Error: func(error) {}}
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	pkg, _ := conf.Check(files[0].Name.Name, fset, files, info)
+	if pkg == nil {
+		return result
+	}
+
+	qualifier := func(p *types.Package) string { return pkg.Name() }
+	for id, obj := range info.Defs {
+		v, ok := obj.(*types.Var)
+		if !ok {
+			continue
+		}
+		pos := fset.Position(id.Pos())
+		fn := funcAt(filepath.Base(pos.Filename), pos.Line, ranges)
+		if fn == "" {
+			continue
+		}
+		result[fn+"|"+id.Name] = types.TypeString(v.Type(), qualifier)
+	}
+	return result
+}
@@ -0,0 +1,26 @@
+package escapetest
+
+import "testing"
+
+func TestAnalyzeStackVsHeap(t *testing.T) {
+	facts, err := Analyze("../examples")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if len(facts) == 0 {
+		t.Fatal("expected at least one escape-analysis fact, got none")
+	}
+
+	var heapEscapes int
+	for _, f := range facts {
+		if f.Func == "calculateAverageStack" && f.Reason != "does not escape" {
+			t.Errorf("calculateAverageStack: want zero escapes, got %+v", f)
+		}
+		if f.Func == "escapesToHeap1" && (f.Reason == "moved to heap" || f.Reason == "escapes to heap") {
+			heapEscapes++
+		}
+	}
+	if heapEscapes != 1 {
+		t.Errorf("escapesToHeap1: want exactly 1 heap escape, got %d", heapEscapes)
+	}
+}
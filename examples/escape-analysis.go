@@ -186,4 +186,7 @@ func main() {
 	fmt.Println("  'moved to heap: student'")
 	fmt.Println("  '&student escapes to heap'")
 	fmt.Println("\nThis tells you which variables Go puts on the heap!")
+
+	fmt.Println()
+	runMemProfileDemo()
 }
@@ -0,0 +1,34 @@
+// Package main demonstrates the memprofile subsystem by measuring the
+// call sites that actually allocate in the other demos.
+// Run all examples with: go run examples/*.go
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/shreya-sk/go-memory-pattern/memprofile"
+)
+
+// runMemProfileDemo measures where the other demos actually spend their
+// bytes by wrapping each run*Demo function with memprofile.MeasureAllocs.
+func runMemProfileDemo() {
+	fmt.Println("Memory Profile Deltas")
+	fmt.Println("=====================")
+
+	memprofile.MeasureAllocs("runStackVsHeapDemo", runStackVsHeapDemo)
+	memprofile.MeasureAllocs("runValueVsPointerDemo", runValueVsPointerDemo)
+
+	f, err := os.Create("heap.pprof")
+	if err != nil {
+		fmt.Printf("could not create heap profile: %v\n", err)
+		return
+	}
+	defer f.Close()
+
+	if err := memprofile.WriteHeapProfile(f); err != nil {
+		fmt.Printf("could not write heap profile: %v\n", err)
+		return
+	}
+	fmt.Println("\nWrote heap.pprof - open with: go tool pprof heap.pprof")
+}
@@ -0,0 +1,101 @@
+// Package main demonstrates a pooled allocation strategy using sync.Pool
+// This file contains functions only - no main function
+// Run all examples with: go run examples/*.go
+package main
+
+import "sync"
+
+// =============================================================================
+// POOLED APPROACH (REUSE INSTEAD OF ALLOCATE)
+// =============================================================================
+
+// StudentPool recycles *Student values so callers that need a scratch
+// student don't pay for a fresh heap allocation every time.
+var StudentPool = sync.Pool{
+	New: func() any { return new(Student) },
+}
+
+// maxPooledGrades caps the capacity of a buffer returned to the pool, so one
+// unusually large caller can't pin a huge backing array in memory forever.
+const maxPooledGrades = 4096
+
+// GradesBuffer hands out []float64 scratch space backed by a sync.Pool,
+// resetting length to zero on Get and discarding oversized buffers on Put so
+// the pool doesn't grow without bound.
+type GradesBuffer struct {
+	pool sync.Pool
+}
+
+// NewGradesBuffer returns a GradesBuffer ready for use.
+func NewGradesBuffer() *GradesBuffer {
+	return &GradesBuffer{
+		pool: sync.Pool{
+			New: func() any {
+				buf := make([]float64, 0, 64)
+				return &buf
+			},
+		},
+	}
+}
+
+// Get returns a zero-length slice with at least capacity n, reusing a
+// pooled backing array when one is available.
+func (g *GradesBuffer) Get(n int) []float64 {
+	buf := *g.pool.Get().(*[]float64)
+	if cap(buf) < n {
+		buf = make([]float64, 0, n)
+	}
+	return buf[:0]
+}
+
+// Put returns buf to the pool for reuse. Buffers that grew past
+// maxPooledGrades are dropped instead of pooled, so one large caller can't
+// keep an oversized array alive indefinitely.
+func (g *GradesBuffer) Put(buf []float64) {
+	if cap(buf) > maxPooledGrades {
+		return
+	}
+	g.pool.Put(&buf)
+}
+
+// gradesPool is the shared GradesBuffer used by calculateAveragePooled.
+var gradesPool = NewGradesBuffer()
+
+// calculateAveragePooled borrows a grades buffer from gradesPool instead of
+// allocating a fresh slice per call.
+func calculateAveragePooled(students []Student) float64 {
+	grades := gradesPool.Get(len(students))
+	defer gradesPool.Put(grades)
+
+	for _, student := range students {
+		grades = append(grades, student.Grade)
+	}
+
+	var total float64
+	for _, grade := range grades {
+		total += grade
+	}
+
+	if len(grades) == 0 {
+		return 0
+	}
+	return total / float64(len(grades))
+}
+
+// createStudentPooled borrows a *Student from StudentPool and fills it in,
+// the pointer analogue of calculateAveragePooled. Callers must return the
+// student to the pool via releaseStudentPooled once done with it.
+func createStudentPooled(id int64, name string, grade float64) *Student {
+	student := StudentPool.Get().(*Student)
+	student.ID = id
+	student.Name = name
+	student.Grade = grade
+	student.Age = 18 + int(id%10)
+	return student
+}
+
+// releaseStudentPooled returns a *Student obtained from createStudentPooled
+// to StudentPool for reuse.
+func releaseStudentPooled(student *Student) {
+	StudentPool.Put(student)
+}
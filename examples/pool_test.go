@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+var benchStudents = func() []Student {
+	students := make([]Student, 100)
+	for i := range students {
+		students[i] = Student{ID: int64(i), Name: "Student", Grade: 60.0 + float64(i%40), Age: 18 + i%10}
+	}
+	return students
+}()
+
+// BenchmarkCalculateAverageOptimizedParallel pre-allocates a fresh grades
+// slice per call, same as the single-goroutine case, to show the baseline
+// cost of per-call allocation under contention.
+func BenchmarkCalculateAverageOptimizedParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = calculateAverageOptimized(benchStudents)
+		}
+	})
+}
+
+// BenchmarkCalculateAveragePooledParallel exercises the sync.Pool-backed
+// path, where concurrent goroutines contend over a shared pool of grades
+// buffers instead of each allocating their own.
+func BenchmarkCalculateAveragePooledParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = calculateAveragePooled(benchStudents)
+		}
+	})
+}
+
+// BenchmarkCreateStudentByPointerParallel allocates a fresh *Student per
+// call, the baseline StudentPool is meant to improve on under contention.
+func BenchmarkCreateStudentByPointerParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = createStudentByPointer(1, "Student", 80.0)
+		}
+	})
+}
+
+// BenchmarkCreateStudentPooledParallel borrows and releases a *Student from
+// StudentPool per call, to compare against per-call allocation.
+func BenchmarkCreateStudentPooledParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s := createStudentPooled(1, "Student", 80.0)
+			releaseStudentPooled(s)
+		}
+	})
+}
@@ -153,10 +153,24 @@ func runPerformanceTest() {
 	runtime.ReadMemStats(&m2)
 	optimizedAllocs := m2.TotalAlloc - m1.TotalAlloc
 
+	// Test pooled approach
+	runtime.GC()
+	runtime.ReadMemStats(&m1)
+
+	start = time.Now()
+	for i := 0; i < iterations; i++ {
+		_ = calculateAveragePooled(students)
+	}
+	pooledTime := time.Since(start)
+
+	runtime.ReadMemStats(&m2)
+	pooledAllocs := m2.TotalAlloc - m1.TotalAlloc
+
 	// Display results
 	fmt.Printf("Stack approach:     %8v  %10d bytes\n", stackTime, stackAllocs)
 	fmt.Printf("Heap approach:      %8v  %10d bytes\n", heapTime, heapAllocs)
 	fmt.Printf("Optimized approach: %8v  %10d bytes\n", optimizedTime, optimizedAllocs)
+	fmt.Printf("Pooled approach:    %8v  %10d bytes\n", pooledTime, pooledAllocs)
 
 	if stackTime > 0 && heapTime > 0 {
 		fmt.Printf("\nPerformance improvements:\n")
@@ -164,6 +178,9 @@ func runPerformanceTest() {
 		if optimizedTime > 0 {
 			fmt.Printf("• Optimized is %.1fx faster than heap\n", float64(heapTime)/float64(optimizedTime))
 		}
+		if pooledTime > 0 {
+			fmt.Printf("• Pooled is %.1fx faster than heap\n", float64(heapTime)/float64(pooledTime))
+		}
 	}
 }
 
@@ -185,6 +202,10 @@ func runStackVsHeapDemo() {
 	heapStudent := createStudentByPointer(2, "Bob", 92.0)
 	fmt.Printf("Heap student: %+v\n", heapStudent)
 
+	pooledStudent := createStudentPooled(3, "Carol", 88.0)
+	fmt.Printf("Pooled student: %+v\n", pooledStudent)
+	releaseStudentPooled(pooledStudent)
+
 	// Performance test
 	fmt.Println("\n--- Performance Test ---")
 	runPerformanceTest()
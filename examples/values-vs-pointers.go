@@ -3,7 +3,11 @@
 // Run all examples with: go run examples/*.go
 package main
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/shreya-sk/go-memory-pattern/collection"
+)
 
 // =============================================================================
 // VALUE OPERATIONS (WORKING WITH COPIES)
@@ -157,6 +161,36 @@ func demonstrateSliceBehavior() {
 	}
 }
 
+// =============================================================================
+// GENERIC COLLECTION (REPLACES THE AD-HOC *[]Student PATTERN)
+// =============================================================================
+
+// demonstrateCollectionStrategies shows collection.Collection[Student] as a
+// reusable alternative to the addStudentToClass(*[]Student, Student) pattern
+// above.
+func demonstrateCollectionStrategies() {
+	fmt.Println("\n=== GENERIC COLLECTION STRATEGIES ===")
+
+	for _, strategy := range []struct {
+		name     string
+		strategy collection.Strategy
+	}{
+		{"ByValue", collection.ByValue},
+		{"ByPointer", collection.ByPointer},
+		{"ByHandle", collection.ByHandle},
+	} {
+		class := collection.New[Student](strategy.strategy)
+
+		id := class.Insert(Student{ID: 1, Name: "Charlie", Grade: 82.0, Age: 19})
+		class.Insert(Student{ID: 2, Name: "Diana", Grade: 91.0, Age: 20})
+
+		class.Update(id, func(s *Student) { s.Grade += 5.0 })
+
+		student, _ := class.Get(id)
+		fmt.Printf("%-10s %d students, %s now has grade %.1f\n", strategy.name, class.Len(), student.Name, student.Grade)
+	}
+}
+
 // =============================================================================
 // WHEN TO USE EACH
 // =============================================================================
@@ -198,6 +232,7 @@ func runValueVsPointerDemo() {
 	demonstrateValueBehavior()
 	demonstratePointerBehavior()
 	demonstrateSliceBehavior()
+	demonstrateCollectionStrategies()
 
 	// Practical usage examples
 	demonstrateWhenToUseEach()
@@ -0,0 +1,144 @@
+// Package memprofile snapshots runtime.MemProfile records around a block of
+// code and reports the allocation delta attributed to each call site.
+package memprofile
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strings"
+)
+
+// SiteDelta is the allocation change attributed to one call site (a
+// runtime.MemProfileRecord stack) across a measured block of code.
+type SiteDelta struct {
+	Stack        []string // function names, innermost first
+	AllocBytes   int64
+	AllocObjects int64
+	InUseBytes   int64
+	InUseObjects int64
+}
+
+// String renders the stack as a single "a -> b -> c" line for display.
+func (s SiteDelta) String() string {
+	return strings.Join(s.Stack, " -> ")
+}
+
+// MeasureAllocs runs fn once, forcing two GCs before and after to settle
+// memory state, and returns the per-callsite allocation delta observed in
+// between. It also prints a top-N table (by AllocBytes) under name.
+func MeasureAllocs(name string, fn func()) []SiteDelta {
+	before, err := snapshot()
+	if err != nil {
+		fmt.Printf("%s: memprofile snapshot failed: %v\n", name, err)
+		before = nil
+	}
+
+	fn()
+
+	after, err := snapshot()
+	if err != nil {
+		fmt.Printf("%s: memprofile snapshot failed: %v\n", name, err)
+		return nil
+	}
+
+	deltas := diff(before, after)
+	printTop(name, deltas, 5)
+	return deltas
+}
+
+// WriteHeapProfile writes a pprof-compatible heap profile of the current
+// process to w, so it can be opened with `go tool pprof`.
+func WriteHeapProfile(w io.Writer) error {
+	runtime.GC()
+	return pprof.WriteHeapProfile(w)
+}
+
+// snapshot forces two GCs (the standard way to settle transient garbage
+// before sampling) and returns the current heap profile records, growing
+// the buffer and retrying until it's large enough to hold every record.
+func snapshot() ([]runtime.MemProfileRecord, error) {
+	runtime.GC()
+	runtime.GC()
+
+	for n := 1024; ; n *= 2 {
+		recs := make([]runtime.MemProfileRecord, n)
+		count, ok := runtime.MemProfile(recs, true)
+		if ok {
+			return recs[:count], nil
+		}
+		if count > n {
+			continue
+		}
+		return nil, fmt.Errorf("memprofile: MemProfile reported ok=false with count %d <= buffer %d", count, n)
+	}
+}
+
+// diff attributes the change between before and after to each distinct call
+// stack, keyed by the function names in the record's Stack0.
+func diff(before, after []runtime.MemProfileRecord) []SiteDelta {
+	byStack := make(map[string]*SiteDelta)
+
+	add := func(rec runtime.MemProfileRecord, sign int64) {
+		key, stack := stackKey(rec)
+		d, ok := byStack[key]
+		if !ok {
+			d = &SiteDelta{Stack: stack}
+			byStack[key] = d
+		}
+		allocBytes, allocObjects := rec.AllocBytes, rec.AllocObjects
+		inUseBytes, inUseObjects := rec.InUseBytes(), rec.InUseObjects()
+		d.AllocBytes += sign * allocBytes
+		d.AllocObjects += sign * allocObjects
+		d.InUseBytes += sign * inUseBytes
+		d.InUseObjects += sign * inUseObjects
+	}
+
+	for _, rec := range before {
+		add(rec, -1)
+	}
+	for _, rec := range after {
+		add(rec, 1)
+	}
+
+	deltas := make([]SiteDelta, 0, len(byStack))
+	for _, d := range byStack {
+		deltas = append(deltas, *d)
+	}
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].AllocBytes > deltas[j].AllocBytes
+	})
+	return deltas
+}
+
+// stackKey builds a stable map key and a human-readable frame list for a
+// profile record's call stack.
+func stackKey(rec runtime.MemProfileRecord) (string, []string) {
+	stack := rec.Stack()
+	frames := make([]string, 0, len(stack))
+	for _, pc := range stack {
+		fn := runtime.FuncForPC(pc)
+		if fn == nil {
+			continue
+		}
+		frames = append(frames, fn.Name())
+	}
+	return strings.Join(frames, "|"), frames
+}
+
+// printTop prints the n highest-AllocBytes deltas under a heading of name.
+func printTop(name string, deltas []SiteDelta, n int) {
+	fmt.Printf("\n--- MeasureAllocs: %s ---\n", name)
+	if len(deltas) == 0 {
+		fmt.Println("(no allocation delta recorded)")
+		return
+	}
+	if n > len(deltas) {
+		n = len(deltas)
+	}
+	for _, d := range deltas[:n] {
+		fmt.Printf("%10d bytes  %8d objs  %s\n", d.AllocBytes, d.AllocObjects, d.String())
+	}
+}
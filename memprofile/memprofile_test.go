@@ -0,0 +1,37 @@
+package memprofile
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestMeasureAllocsReportsHeapGrowth(t *testing.T) {
+	// MemProfile is sampled at runtime.MemProfileRate bytes by default, so
+	// small test allocations may not be recorded at all; profile every
+	// allocation for the duration of this test to make it deterministic.
+	prevRate := runtime.MemProfileRate
+	runtime.MemProfileRate = 1
+	defer func() { runtime.MemProfileRate = prevRate }()
+
+	var sink [][]byte
+
+	deltas := MeasureAllocs("alloc 1000 buffers", func() {
+		for i := 0; i < 1000; i++ {
+			sink = append(sink, make([]byte, 1024))
+		}
+	})
+
+	if len(deltas) == 0 {
+		t.Fatal("expected at least one call-site delta, got none")
+	}
+
+	var totalBytes int64
+	for _, d := range deltas {
+		totalBytes += d.AllocBytes
+	}
+	if totalBytes <= 0 {
+		t.Errorf("expected positive total AllocBytes, got %d", totalBytes)
+	}
+
+	_ = sink
+}